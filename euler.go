@@ -1,10 +1,18 @@
 package mathlib
 
-import "math"
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// EulerOrder identifies the order in which the three axis rotations of an
+// Euler angle are applied.
+type EulerOrder uint8
 
 // define the different euler orders
 const (
-	EulerOrderXYZ = iota
+	EulerOrderXYZ EulerOrder = iota
 	EulerOrderYXZ
 	EulerOrderZXY
 	EulerOrderZYX
@@ -18,13 +26,110 @@ const (
 	EulerOrderDefault = EulerOrderXYZ
 )
 
+// String returns the canonical three-letter name of the order (e.g. "XYZ").
+func (o EulerOrder) String() string {
+	switch o {
+	case EulerOrderXYZ:
+		return "XYZ"
+	case EulerOrderYXZ:
+		return "YXZ"
+	case EulerOrderZXY:
+		return "ZXY"
+	case EulerOrderZYX:
+		return "ZYX"
+	case EulerOrderYZX:
+		return "YZX"
+	case EulerOrderXZY:
+		return "XZY"
+	default:
+		return "XYZ"
+	}
+}
+
+// ParseEulerOrder parses a three-letter order name (case-insensitive) into
+// an EulerOrder. It returns an error if the name is not one of the six
+// supported orders.
+func ParseEulerOrder(s string) (EulerOrder, error) {
+	switch strings.ToUpper(s) {
+	case "XYZ":
+		return EulerOrderXYZ, nil
+	case "YXZ":
+		return EulerOrderYXZ, nil
+	case "ZXY":
+		return EulerOrderZXY, nil
+	case "ZYX":
+		return EulerOrderZYX, nil
+	case "YZX":
+		return EulerOrderYZX, nil
+	case "XZY":
+		return EulerOrderXZY, nil
+	default:
+		return EulerOrderDefault, fmt.Errorf("mathlib: unknown euler order %q", s)
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (o EulerOrder) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *EulerOrder) UnmarshalText(text []byte) error {
+	order, err := ParseEulerOrder(string(text))
+	if err != nil {
+		return err
+	}
+
+	*o = order
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the order as its
+// three-letter string name.
+func (o EulerOrder) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + o.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the order from its
+// three-letter string name.
+func (o *EulerOrder) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	order, err := ParseEulerOrder(s)
+	if err != nil {
+		return err
+	}
+
+	*o = order
+
+	return nil
+}
+
+// EulerUnit identifies whether an Euler's components are expressed in
+// radians or degrees.
+type EulerUnit uint8
+
+// the units an Euler's components may be expressed in
+const (
+	// UnitRadians is the default, matching every other angle in this
+	// library.
+	UnitRadians EulerUnit = iota
+	// UnitDegrees is used by formats (glTF, FBX exporters, graphene) that
+	// express Euler angles in degrees.
+	UnitDegrees
+)
+
 func eulerNoop(_ *Euler) { /* do nothing */ }
 
 // static check that euler is Vector3Like
 var _ Vector3Like = &Euler{}
 
 // NewEuler creates a Euler
-func NewEuler(x, y, z float64, order int) *Euler {
+func NewEuler(x, y, z float64, order EulerOrder) *Euler {
 	return &Euler{
 		X:                x,
 		Y:                y,
@@ -37,8 +142,42 @@ func NewEuler(x, y, z float64, order int) *Euler {
 // Euler is an abstraction of a Euler angle
 type Euler struct {
 	X, Y, Z          float64
-	Order            int
+	Order            EulerOrder
 	OnChangeCallback func(*Euler)
+
+	// Unit reports whether X, Y, Z are expressed in radians or degrees. It
+	// defaults to UnitRadians, matching the library's historical behavior.
+	Unit EulerUnit
+
+	observable Observable[*Euler]
+}
+
+// radians returns X, Y, Z normalized to radians regardless of Unit. Internal
+// math (ToQuaternion, the rotation-matrix extraction, gimbal-lock checks)
+// always works in radians.
+func (e *Euler) radians() (x, y, z float64) {
+	if e.Unit == UnitDegrees {
+		return e.X * math.Pi / 180, e.Y * math.Pi / 180, e.Z * math.Pi / 180
+	}
+
+	return e.X, e.Y, e.Z
+}
+
+// Subscribe registers fn to be called every time this Euler changes, in
+// addition to OnChangeCallback, and returns a function that removes the
+// subscription when called.
+func (e *Euler) Subscribe(fn func(*Euler)) (unsubscribe func()) {
+	return e.observable.Subscribe(fn)
+}
+
+// NotifyChange fires OnChangeCallback and every subscriber registered via
+// Subscribe. Callers that batch several SetSilent calls should invoke this
+// once afterwards instead of notifying on every intermediate mutation.
+func (e *Euler) NotifyChange() *Euler {
+	e.OnChangeCallback(e)
+	e.observable.Notify(e)
+
+	return e
 }
 
 // XY returns the x and y components of the quaternion
@@ -67,16 +206,23 @@ func (e *Euler) SetZ(v float64) *Euler {
 }
 
 // SetOrder sets the order of the components
-func (e *Euler) SetOrder(v int) *Euler {
+func (e *Euler) SetOrder(v EulerOrder) *Euler {
 	return e.Set(e.X, e.Y, e.Z, v)
 }
 
 // Set sets the x, y, and z components, as well as the order
-func (e *Euler) Set(x, y, z float64, order int) *Euler {
-	order = int(Clamp(float64(order), 0, numEulerOrders-1))
-	e.X, e.Y, e.Z, e.Order = x, y, z, order
+func (e *Euler) Set(x, y, z float64, order EulerOrder) *Euler {
+	e.SetSilent(x, y, z, order)
 
-	e.OnChangeCallback(e)
+	return e.NotifyChange()
+}
+
+// SetSilent sets the x, y, and z components, as well as the order, without
+// notifying OnChangeCallback or any subscribers. Callers doing batch updates
+// can make several SetSilent calls and then call NotifyChange once.
+func (e *Euler) SetSilent(x, y, z float64, order EulerOrder) *Euler {
+	order = EulerOrder(Clamp(float64(order), 0, float64(numEulerOrders-1)))
+	e.X, e.Y, e.Z, e.Order = x, y, z, order
 
 	return e
 }
@@ -86,17 +232,252 @@ func (e *Euler) Copy(other *Euler) *Euler {
 	return e.Set(other.X, other.Y, other.Z, other.Order)
 }
 
-// SetFromQuaternion sets the values from a Quarternion in the specified order
-func (e *Euler) SetFromQuaternion(q *Quaternion, order int) *Euler {
-	tmpMat4 := NewMatrix4(nil)
+// Clone returns a new Euler with the same values, order and unit as this one
+func (e *Euler) Clone() *Euler {
+	out := NewEuler(e.X, e.Y, e.Z, e.Order)
+	out.Unit = e.Unit
 
-	tmpMat4.FromQuaternion(q)
+	return out
+}
+
+// Equals returns true if the given Euler has the same values, order and unit
+// as this one
+func (e *Euler) Equals(other *Euler) bool {
+	return e.X == other.X && e.Y == other.Y && e.Z == other.Z &&
+		e.Order == other.Order && e.Unit == other.Unit
+}
+
+// InRadians returns a new Euler with X, Y, Z converted to radians. If this
+// Euler is already in radians, it is equivalent to Clone.
+func (e *Euler) InRadians() *Euler {
+	x, y, z := e.radians()
+	out := NewEuler(x, y, z, e.Order)
+	out.Unit = UnitRadians
+
+	return out
+}
+
+// InDegrees returns a new Euler with X, Y, Z converted to degrees. If this
+// Euler is already in degrees, it is equivalent to Clone.
+func (e *Euler) InDegrees() *Euler {
+	x, y, z := e.XYZDegrees()
+	out := NewEuler(x, y, z, e.Order)
+	out.Unit = UnitDegrees
+
+	return out
+}
+
+// SetFromDegrees sets the x, y, and z components, given in degrees, as well
+// as the order, and marks this Euler as holding degrees.
+func (e *Euler) SetFromDegrees(x, y, z float64, order EulerOrder) *Euler {
+	e.SetSilent(x, y, z, order)
+	e.Unit = UnitDegrees
+
+	return e.NotifyChange()
+}
+
+// XYZDegrees returns the x, y and z components converted to degrees,
+// regardless of the Euler's stored Unit.
+func (e *Euler) XYZDegrees() (x, y, z float64) {
+	if e.Unit == UnitDegrees {
+		return e.X, e.Y, e.Z
+	}
+
+	return e.X * 180 / math.Pi, e.Y * 180 / math.Pi, e.Z * 180 / math.Pi
+}
+
+// FromArray sets the values from the given slice. The slice may hold
+// x, y, z or x, y, z, order (order encoded numerically); any trailing
+// elements that are omitted leave the current value untouched.
+func (e *Euler) FromArray(a []float64) *Euler {
+	x, y, z, order := e.X, e.Y, e.Z, e.Order
+
+	if len(a) > 0 {
+		x = a[0]
+	}
+
+	if len(a) > 1 {
+		y = a[1]
+	}
+
+	if len(a) > 2 {
+		z = a[2]
+	}
+
+	if len(a) > 3 {
+		order = EulerOrder(a[3])
+	}
+
+	return e.Set(x, y, z, order)
+}
+
+// ToArray returns the x, y, z components and the order (as its three-letter
+// name) packed into a 4-element array.
+func (e *Euler) ToArray() [4]interface{} {
+	return [4]interface{}{e.X, e.Y, e.Z, e.Order.String()}
+}
+
+// Reorder recomputes the Euler decomposition for newOrder while preserving
+// the rotation it represents. This is done via Euler->Quaternion->Euler
+// rather than re-deriving directly from the existing angles, since that
+// would accumulate matrix error across repeated calls. SetFromQuaternion
+// always resolves to radians, so a degrees-tagged Euler is converted back
+// to degrees afterwards - Reorder changes the order, not the unit.
+// OnChangeCallback and all subscribers fire exactly once, after the
+// reorder completes.
+func (e *Euler) Reorder(newOrder EulerOrder) *Euler {
+	q := e.ToQuaternion(nil)
+	unit := e.Unit
+
+	e.SetFromQuaternion(q, newOrder, false)
+
+	if unit == UnitDegrees {
+		x, y, z := e.XYZDegrees()
+		e.SetSilent(x, y, z, e.Order)
+		e.Unit = UnitDegrees
+	}
+
+	return e.NotifyChange()
+}
+
+// ToQuaternion sets out (or a new Quaternion if out is nil) to the rotation
+// represented by this Euler and returns it. The conversion always works in
+// radians, regardless of this Euler's Unit.
+func (e *Euler) ToQuaternion(out *Quaternion) *Quaternion {
+	if out == nil {
+		out = &Quaternion{}
+	}
+
+	return out.SetFromEuler(e.InRadians())
+}
+
+// ToVector3 sets out (or a new Vector3 if out is nil) to the x, y, z
+// components of this Euler and returns it. Like ToQuaternion, this always
+// works in radians, regardless of this Euler's Unit.
+func (e *Euler) ToVector3(out *Vector3) *Vector3 {
+	if out == nil {
+		out = &Vector3{}
+	}
 
-	return e.SetFromRotationMatrix(tmpMat4, order)
+	x, y, z := e.radians()
+
+	return out.Set(x, y, z)
 }
 
-// SetFromRotationMatrix sets the values from a matrix in the specified order
-func (e *Euler) SetFromRotationMatrix(m4 *Matrix4, order int) *Euler {
+// SingularityKind identifies which pole a near-singular Euler extraction
+// landed on.
+type SingularityKind int
+
+// the kinds of gimbal-lock singularity an extraction can land on
+const (
+	// SingularityNone indicates the extraction was not near a pole.
+	SingularityNone SingularityKind = iota
+	// SingularityNorthPole indicates the pivot angle saturated at +pi/2.
+	SingularityNorthPole
+	// SingularitySouthPole indicates the pivot angle saturated at -pi/2.
+	SingularitySouthPole
+)
+
+// ExtractionInfo reports extra detail about an Euler extraction performed
+// near a gimbal-lock pole, where the decomposition is not unique: only the
+// sum or difference of two of the three angles can be recovered, not each
+// individually.
+type ExtractionInfo struct {
+	// AtSingularity is true when the configuration was within epsilon of a
+	// pole.
+	AtSingularity bool
+
+	// SingularityKind reports which pole was hit. It is SingularityNone
+	// unless AtSingularity is true.
+	SingularityKind SingularityKind
+
+	// Alternate holds the second, equally valid solution for the same
+	// rotation: one axis flipped by pi and the other two adjusted to
+	// compensate. Nil unless AtSingularity is true.
+	Alternate *Euler
+}
+
+// IsGimbalLocked reports whether this Euler's current values, rebuilt into
+// a rotation matrix, sit within epsilon of a gimbal-lock pole for its order.
+func (e *Euler) IsGimbalLocked(epsilon float64) bool {
+	m := NewMatrix4(nil)
+	m.MakeRotationFromEuler(e.InRadians())
+
+	v := m.Values
+
+	switch e.Order {
+	case EulerOrderYXZ:
+		return math.Abs(v[9]) >= epsilon // m23
+	case EulerOrderZXY:
+		return math.Abs(v[6]) >= epsilon // m32
+	case EulerOrderZYX:
+		return math.Abs(v[2]) >= epsilon // m31
+	case EulerOrderYZX:
+		return math.Abs(v[1]) >= epsilon // m21
+	case EulerOrderXZY:
+		return math.Abs(v[4]) >= epsilon // m12
+	case EulerOrderXYZ:
+		fallthrough
+	default:
+		return math.Abs(v[8]) >= epsilon // m13
+	}
+}
+
+// SetFromRotationMatrixSafe is the singularity-aware counterpart to
+// SetFromRotationMatrix. Near a pole (|pivot value| >= epsilon) a single
+// rotation matrix no longer determines the three angles uniquely - only
+// their sum or difference is recoverable - so in addition to the result
+// this reports whether that happened and, if so, the second equally valid
+// solution (the pivot angle reflected across the pole, the recovered angle
+// shifted by pi, and the degenerate angle pinned to pi).
+func (e *Euler) SetFromRotationMatrixSafe(m4 *Matrix4, order EulerOrder) (result *Euler, info ExtractionInfo) {
+	x, y, z, atSingularity, pivotSign := eulerExtract(m4, order)
+
+	e.SetSilent(x, y, z, order)
+	e.Unit = UnitRadians
+	e.NotifyChange()
+
+	info.AtSingularity = atSingularity
+
+	if !atSingularity {
+		return e, info
+	}
+
+	if pivotSign >= 0 {
+		info.SingularityKind = SingularityNorthPole
+	} else {
+		info.SingularityKind = SingularitySouthPole
+	}
+
+	ax, ay, az := x, y, z
+
+	switch order {
+	case EulerOrderYXZ: // pivot=x, combined=y, zeroed=z
+		ax, ay, az = math.Pi-x, y+math.Pi, math.Pi
+	case EulerOrderZXY: // pivot=x, combined=z, zeroed=y
+		ax, ay, az = math.Pi-x, math.Pi, z+math.Pi
+	case EulerOrderZYX: // pivot=y, combined=z, zeroed=x
+		ax, ay, az = math.Pi, math.Pi-y, z+math.Pi
+	case EulerOrderYZX: // pivot=z, combined=y, zeroed=x
+		ax, ay, az = math.Pi, y+math.Pi, math.Pi-z
+	case EulerOrderXZY: // pivot=z, combined=x, zeroed=y
+		ax, ay, az = x+math.Pi, math.Pi, math.Pi-z
+	case EulerOrderXYZ:
+		fallthrough
+	default: // pivot=y, combined=x, zeroed=z
+		ax, ay, az = x+math.Pi, math.Pi-y, math.Pi
+	}
+
+	info.Alternate = NewEuler(ax, ay, az, order)
+
+	return e, info
+}
+
+// eulerExtract runs the branch-per-order extraction shared by
+// SetFromRotationMatrix and SetFromRotationMatrixSafe. pivotSign is the
+// pre-clamp value fed to asin for the pivot angle; its sign distinguishes
+// the north pole from the south pole when atSingularity is true.
+func eulerExtract(m4 *Matrix4, order EulerOrder) (x, y, z float64, atSingularity bool, pivotSign float64) {
 	m := m4.Values
 
 	m11, m12, m13,
@@ -106,50 +487,59 @@ func (e *Euler) SetFromRotationMatrix(m4 *Matrix4, order int) *Euler {
 		m[1], m[5], m[9],
 		m[2], m[6], m[10]
 
-	x, y, z := 0., 0., 0.
 	epsilon := 0.99999
 
-	switch e.Order {
+	switch order {
 	case EulerOrderYXZ:
+		pivotSign = -m23
 		x = math.Asin(-Clamp(m23, -1, 1))
+		atSingularity = math.Abs(m23) >= epsilon
 
-		if math.Abs(m23) < epsilon {
+		if !atSingularity {
 			y = math.Atan2(m13, m33)
 			z = math.Atan2(m21, m22)
 		} else {
 			y = math.Atan2(-m31, m11)
 		}
 	case EulerOrderZXY:
+		pivotSign = m32
 		x = math.Asin(Clamp(m32, -1, 1))
+		atSingularity = math.Abs(m32) >= epsilon
 
-		if math.Abs(m32) < epsilon {
+		if !atSingularity {
 			y = math.Atan2(-m31, m33)
 			z = math.Atan2(-m12, m22)
 		} else {
 			z = math.Atan2(m21, m11)
 		}
 	case EulerOrderZYX:
+		pivotSign = -m31
 		y = math.Asin(-Clamp(m31, -1, 1))
+		atSingularity = math.Abs(m31) >= epsilon
 
-		if math.Abs(m31) < epsilon {
+		if !atSingularity {
 			x = math.Atan2(m32, m33)
 			z = math.Atan2(m21, m11)
 		} else {
 			z = math.Atan2(-m12, m22)
 		}
 	case EulerOrderYZX:
+		pivotSign = m21
 		z = math.Asin(Clamp(m21, -1, 1))
+		atSingularity = math.Abs(m21) >= epsilon
 
-		if math.Abs(m21) < epsilon {
+		if !atSingularity {
 			x = math.Atan2(-m23, m22)
 			y = math.Atan2(-m31, m11)
 		} else {
 			y = math.Atan2(m13, m33)
 		}
 	case EulerOrderXZY:
+		pivotSign = -m12
 		z = math.Asin(-Clamp(m12, -1, 1))
+		atSingularity = math.Abs(m12) >= epsilon
 
-		if math.Abs(m12) < epsilon {
+		if !atSingularity {
 			x = math.Atan2(m32, m22)
 			y = math.Atan2(m13, m11)
 		} else {
@@ -158,9 +548,11 @@ func (e *Euler) SetFromRotationMatrix(m4 *Matrix4, order int) *Euler {
 	case EulerOrderXYZ:
 		fallthrough //nolint:gocritic // it's better to be explicit and include the fallthrough to default
 	default:
+		pivotSign = m13
 		y = math.Asin(Clamp(m13, -1, 1))
+		atSingularity = math.Abs(m13) >= epsilon
 
-		if math.Abs(m13) < epsilon {
+		if !atSingularity {
 			x = math.Atan2(-m23, m33)
 			z = math.Atan2(-m12, m11)
 		} else {
@@ -168,5 +560,36 @@ func (e *Euler) SetFromRotationMatrix(m4 *Matrix4, order int) *Euler {
 		}
 	}
 
-	return e.Set(x, y, z, order)
+	return x, y, z, atSingularity, pivotSign
+}
+
+// SetFromQuaternion sets the values from a Quarternion in the specified
+// order. The result is always in radians (Unit is set to UnitRadians). When
+// update is false, OnChangeCallback and subscribers are not notified,
+// letting callers batch this with other silent updates before calling
+// NotifyChange once.
+func (e *Euler) SetFromQuaternion(q *Quaternion, order EulerOrder, update bool) *Euler {
+	tmpMat4 := NewMatrix4(nil)
+
+	tmpMat4.FromQuaternion(q)
+
+	return e.SetFromRotationMatrix(tmpMat4, order, update)
+}
+
+// SetFromRotationMatrix sets the values from a matrix in the specified
+// order. The result is always in radians (Unit is set to UnitRadians). When
+// update is false, OnChangeCallback and subscribers are not notified,
+// letting callers batch this with other silent updates before calling
+// NotifyChange once.
+func (e *Euler) SetFromRotationMatrix(m4 *Matrix4, order EulerOrder, update bool) *Euler {
+	x, y, z, _, _ := eulerExtract(m4, order)
+
+	e.SetSilent(x, y, z, order)
+	e.Unit = UnitRadians
+
+	if update {
+		return e.NotifyChange()
+	}
+
+	return e
 }
@@ -0,0 +1,109 @@
+package mathlib
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEulerDegreesRadiansRoundTrip(t *testing.T) {
+	orders := []EulerOrder{
+		EulerOrderXYZ,
+		EulerOrderYXZ,
+		EulerOrderZXY,
+		EulerOrderZYX,
+		EulerOrderYZX,
+		EulerOrderXZY,
+	}
+
+	x, y, z := 30.0, -45.0, 90.0
+
+	for _, order := range orders {
+		e := NewEuler(0, 0, 0, order)
+		e.SetFromDegrees(x, y, z, order)
+
+		if e.Unit != UnitDegrees {
+			t.Fatalf("order %s: expected Unit to be UnitDegrees after SetFromDegrees, got %v", order, e.Unit)
+		}
+
+		radians := e.InRadians()
+
+		if radians.Unit != UnitRadians {
+			t.Fatalf("order %s: expected InRadians() to tag UnitRadians, got %v", order, radians.Unit)
+		}
+
+		rx, ry, rz := radians.XYZ()
+
+		if math.Abs(rx-x*math.Pi/180) > 1e-9 {
+			t.Errorf("order %s: x in radians = %v, want %v", order, rx, x*math.Pi/180)
+		}
+
+		if math.Abs(ry-y*math.Pi/180) > 1e-9 {
+			t.Errorf("order %s: y in radians = %v, want %v", order, ry, y*math.Pi/180)
+		}
+
+		if math.Abs(rz-z*math.Pi/180) > 1e-9 {
+			t.Errorf("order %s: z in radians = %v, want %v", order, rz, z*math.Pi/180)
+		}
+
+		back := radians.InDegrees()
+
+		if back.Unit != UnitDegrees {
+			t.Fatalf("order %s: expected InDegrees() to tag UnitDegrees, got %v", order, back.Unit)
+		}
+
+		bx, by, bz := back.XYZ()
+
+		if math.Abs(bx-x) > 1e-9 {
+			t.Errorf("order %s: round-tripped x = %v, want %v", order, bx, x)
+		}
+
+		if math.Abs(by-y) > 1e-9 {
+			t.Errorf("order %s: round-tripped y = %v, want %v", order, by, y)
+		}
+
+		if math.Abs(bz-z) > 1e-9 {
+			t.Errorf("order %s: round-tripped z = %v, want %v", order, bz, z)
+		}
+	}
+}
+
+func TestEulerGimbalLockDetectionAndSafeExtraction(t *testing.T) {
+	// index, within a Matrix4's Values, of each order's pivot element
+	// (m13, m23, m32, m31, m21, m12 respectively).
+	pivotIndex := map[EulerOrder]int{
+		EulerOrderXYZ: 8,
+		EulerOrderYXZ: 9,
+		EulerOrderZXY: 6,
+		EulerOrderZYX: 2,
+		EulerOrderYZX: 1,
+		EulerOrderXZY: 4,
+	}
+
+	orders := []EulerOrder{
+		EulerOrderXYZ,
+		EulerOrderYXZ,
+		EulerOrderZXY,
+		EulerOrderZYX,
+		EulerOrderYZX,
+		EulerOrderXZY,
+	}
+
+	for _, order := range orders {
+		m := NewMatrix4(nil)
+		m.Values[pivotIndex[order]] = 1
+
+		result, info := NewEuler(0, 0, 0, order).SetFromRotationMatrixSafe(m, order)
+
+		if !info.AtSingularity {
+			t.Errorf("order %s: expected AtSingularity at the pole", order)
+		}
+
+		if info.Alternate == nil {
+			t.Errorf("order %s: expected a non-nil Alternate at the pole", order)
+		}
+
+		if !result.IsGimbalLocked(0.99999) {
+			t.Errorf("order %s: IsGimbalLocked should report true for a pole configuration", order)
+		}
+	}
+}
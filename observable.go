@@ -0,0 +1,34 @@
+package mathlib
+
+// Observable provides simple multi-subscriber change notification, so that
+// more than one interested party - e.g. a transform cache and a dirty-flag
+// system - can observe the same value without stepping on each other's
+// callback. It is generic over the observed type; Euler uses it to back
+// Subscribe. Quaternion, Vector3 and Matrix4 do not use it.
+type Observable[T any] struct {
+	subscribers map[int]func(T)
+	nextID      int
+}
+
+// Subscribe registers fn to be called on every change and returns an
+// unsubscribe function that removes fn when called.
+func (o *Observable[T]) Subscribe(fn func(T)) (unsubscribe func()) {
+	if o.subscribers == nil {
+		o.subscribers = make(map[int]func(T))
+	}
+
+	id := o.nextID
+	o.nextID++
+	o.subscribers[id] = fn
+
+	return func() {
+		delete(o.subscribers, id)
+	}
+}
+
+// Notify calls every current subscriber with v.
+func (o *Observable[T]) Notify(v T) {
+	for _, fn := range o.subscribers {
+		fn(v)
+	}
+}